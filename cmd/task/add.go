@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/scheduler"
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addDescription string
+	addTags        []string
+	addPriority    string
+	addDue         string
+	addRecurrence  string
+)
+
+var addCmd = &cobra.Command{
+	Use:   "add [title]",
+	Short: "Add a new task",
+	Long:  "Add a new task to the task list. Provide the task title as an argument.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("please provide a task title")
+		}
+		title := strings.Join(args, " ")
+
+		priority := tasks.PriorityLow
+		if addPriority != "" {
+			p, err := tasks.ParsePriority(addPriority)
+			if err != nil {
+				return err
+			}
+			priority = p
+		}
+
+		var dueAt *time.Time
+		if addDue != "" {
+			d, err := time.Parse("2006-01-02", addDue)
+			if err != nil {
+				return fmt.Errorf("invalid --due date %q, expected YYYY-MM-DD: %w", addDue, err)
+			}
+			dueAt = &d
+		}
+
+		if addRecurrence != "" {
+			if _, err := scheduler.Next(addRecurrence, time.Now()); err != nil {
+				return fmt.Errorf("invalid --recurrence: %w", err)
+			}
+		}
+
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+		materializeDue(store)
+
+		task, err := store.Add(tasks.Task{
+			Title:       title,
+			Description: addDescription,
+			Completed:   false,
+			CreatedAt:   time.Now(),
+			Tags:        addTags,
+			Priority:    priority,
+			DueAt:       dueAt,
+			Recurrence:  addRecurrence,
+		})
+		if err != nil {
+			return fmt.Errorf("saving task: %w", err)
+		}
+
+		fmt.Printf("Task added: #%d %s\n", task.ID, task.Title)
+		return nil
+	},
+}
+
+func init() {
+	addCmd.Flags().StringVarP(&addDescription, "description", "d", "", "optional task description")
+	addCmd.Flags().StringArrayVar(&addTags, "tag", nil, "tag to attach to the task (repeatable)")
+	addCmd.Flags().StringVar(&addPriority, "priority", "", "priority: low, med, high, or critical (default low)")
+	addCmd.Flags().StringVar(&addDue, "due", "", "due date in YYYY-MM-DD form")
+	addCmd.Flags().StringVar(&addRecurrence, "recurrence", "", "recurring schedule, e.g. daily, weekly:mon,wed, monthly:1,15, or cron:<expr>")
+}
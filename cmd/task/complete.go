@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var completeCmd = &cobra.Command{
+	Use:   "complete [id]",
+	Short: "Mark a task as completed",
+	Long:  "Mark the task with the given ID as completed.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			warnf("Please provide a task ID.")
+			return
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			warnf("Invalid task ID: %s", args[0])
+			return
+		}
+
+		store, err := openStore()
+		if err != nil {
+			warnf("Error opening task store, %s", err)
+			return
+		}
+		defer store.Close()
+		materializeDue(store)
+
+		task, err := store.Get(id)
+		if err != nil {
+			warnf("No task found with ID %d", id)
+			return
+		}
+
+		now := time.Now()
+		task.Completed = true
+		task.CompletedAt = &now
+		if err := store.Update(task); err != nil {
+			warnf("Error saving task, %s", err)
+			return
+		}
+
+		fmt.Printf("Task completed: #%d %s\n", task.ID, task.Title)
+	},
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var countFilter *filterFlags
+
+var countCmd = &cobra.Command{
+	Use:   "count",
+	Short: "Count tasks matching a filter",
+	Long:  "Count tasks, narrowed with the same --tag/--priority/--due/--status flags as 'task list'.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := tasks.ParseFilter(countFilter.expr())
+		if err != nil {
+			return err
+		}
+
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+
+		list, err := store.List(filter)
+		if err != nil {
+			return fmt.Errorf("loading tasks: %w", err)
+		}
+
+		fmt.Println(len(list))
+		return nil
+	},
+}
+
+func init() {
+	countFilter = addFilterFlags(countCmd)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/scheduler"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var daemonInterval time.Duration
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Materialize due recurring tasks on a schedule",
+	Long:  "Run forever, checking every --interval whether a recurring task is due and creating its next instance.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+
+		d := &scheduler.Daemon{Store: store, StoragePath: viper.GetString("storage.path")}
+
+		ticker := time.NewTicker(daemonInterval)
+		defer ticker.Stop()
+
+		fmt.Printf("task daemon running, checking every %s (Ctrl-C to stop)\n", daemonInterval)
+		for {
+			created, err := d.RunOnce()
+			if err != nil {
+				warnf("Error materializing tasks, %s", err)
+			}
+			for _, t := range created {
+				fmt.Printf("Materialized recurring task: #%d %s\n", t.ID, t.Title)
+			}
+			<-ticker.C
+		}
+	},
+}
+
+var daemonStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the next fire time for every recurring task",
+	Long:  "Print, for each recurring template task, when it will next be materialized.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+
+		statuses, err := scheduler.Statuses(store, nil)
+		if err != nil {
+			return fmt.Errorf("computing schedule: %w", err)
+		}
+		if len(statuses) == 0 {
+			fmt.Println("No recurring tasks.")
+			return nil
+		}
+		for _, s := range statuses {
+			fmt.Printf("#%d %s: next at %s (%s)\n", s.Task.ID, s.Task.Title, s.Next.Format("2006-01-02 15:04"), s.Task.Recurrence)
+		}
+		return nil
+	},
+}
+
+func init() {
+	daemonCmd.Flags().DurationVar(&daemonInterval, "interval", time.Minute, "how often to check for due recurring tasks")
+	daemonCmd.AddCommand(daemonStatusCmd)
+}
@@ -0,0 +1,17 @@
+package main
+
+import "github.com/spf13/viper"
+
+// defaultDueDateFormat is used when "task init" was never run, or the
+// wizard's dueDate.format prompt was left at its default.
+const defaultDueDateFormat = "2006-01-02"
+
+// dueDateFormat returns the Go reference-time layout to use when printing
+// a task's due date, as configured by "task init".
+func dueDateFormat() string {
+	format := viper.GetString("dueDate.format")
+	if format == "" {
+		return defaultDueDateFormat
+	}
+	return format
+}
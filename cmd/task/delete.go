@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a task",
+	Long:  "Remove the task with the given ID from the task list.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			warnf("Please provide a task ID.")
+			return
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			warnf("Invalid task ID: %s", args[0])
+			return
+		}
+
+		store, err := openStore()
+		if err != nil {
+			warnf("Error opening task store, %s", err)
+			return
+		}
+		defer store.Close()
+		materializeDue(store)
+
+		task, err := store.Get(id)
+		if err != nil {
+			warnf("No task found with ID %d", id)
+			return
+		}
+
+		if err := store.Delete(id); err != nil {
+			warnf("Error deleting task, %s", err)
+			return
+		}
+
+		fmt.Printf("Task deleted: #%d %s\n", task.ID, task.Title)
+	},
+}
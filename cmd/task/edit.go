@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var (
+	editTitle       string
+	editDescription string
+	editTags        []string
+	editPriority    string
+	editDue         string
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit [id]",
+	Short: "Edit a task",
+	Long:  "Update the fields of the task with the given ID. Only the flags you pass are changed.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("please provide a task ID")
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid task ID: %s", args[0])
+		}
+
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+		materializeDue(store)
+
+		task, err := store.Get(id)
+		if err != nil {
+			return fmt.Errorf("no task found with ID %d", id)
+		}
+
+		if cmd.Flags().Changed("title") {
+			task.Title = editTitle
+		}
+		if cmd.Flags().Changed("description") {
+			task.Description = editDescription
+		}
+		if cmd.Flags().Changed("tag") {
+			task.Tags = editTags
+		}
+		if cmd.Flags().Changed("priority") {
+			p, err := tasks.ParsePriority(editPriority)
+			if err != nil {
+				return err
+			}
+			task.Priority = p
+		}
+		if cmd.Flags().Changed("due") {
+			d, err := time.Parse("2006-01-02", editDue)
+			if err != nil {
+				return fmt.Errorf("invalid --due date %q, expected YYYY-MM-DD: %w", editDue, err)
+			}
+			task.DueAt = &d
+		}
+
+		if err := store.Update(task); err != nil {
+			return fmt.Errorf("saving task: %w", err)
+		}
+
+		fmt.Printf("Task updated: #%d %s\n", task.ID, task.Title)
+		return nil
+	},
+}
+
+func init() {
+	editCmd.Flags().StringVarP(&editTitle, "title", "t", "", "new task title")
+	editCmd.Flags().StringVarP(&editDescription, "description", "d", "", "new task description")
+	editCmd.Flags().StringArrayVar(&editTags, "tag", nil, "replace the task's tags (repeatable)")
+	editCmd.Flags().StringVar(&editPriority, "priority", "", "new priority: low, med, high, or critical")
+	editCmd.Flags().StringVar(&editDue, "due", "", "new due date in YYYY-MM-DD form")
+}
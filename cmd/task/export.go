@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var exportSort string
+
+var exportFilter *filterFlags
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export tasks matching a filter as JSON",
+	Long:  "Print tasks as a JSON array, narrowed with the same --tag/--priority/--due/--status flags as 'task list' and ordered with --sort.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := tasks.ParseFilter(exportFilter.expr())
+		if err != nil {
+			return err
+		}
+		sortKeys, err := tasks.ParseSort(exportSort)
+		if err != nil {
+			return err
+		}
+
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+
+		list, err := store.List(filter)
+		if err != nil {
+			return fmt.Errorf("loading tasks: %w", err)
+		}
+		tasks.Sort(list, sortKeys)
+
+		data, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding tasks: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	},
+}
+
+func init() {
+	exportFilter = addFilterFlags(exportCmd)
+	exportCmd.Flags().StringVar(&exportSort, "sort", "", "sort order, e.g. due,-priority")
+}
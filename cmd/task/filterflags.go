@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// filterFlags holds the --tag/--priority/--due/--status flags shared by any
+// command that filters the task list, and assembles them into the filter
+// expression understood by tasks.ParseFilter.
+type filterFlags struct {
+	tags     []string
+	priority string
+	due      string
+	status   string
+}
+
+func addFilterFlags(cmd *cobra.Command) *filterFlags {
+	f := &filterFlags{}
+	cmd.Flags().StringArrayVar(&f.tags, "tag", nil, "only include tasks with this tag (repeatable)")
+	cmd.Flags().StringVar(&f.priority, "priority", "", "only include tasks matching this priority, e.g. >=high")
+	cmd.Flags().StringVar(&f.due, "due", "", "only include tasks matching this due date, e.g. before:tomorrow")
+	cmd.Flags().StringVar(&f.status, "status", "open", "only include tasks with this status: open, done, or all")
+	return f
+}
+
+// expr assembles the flags into a filter expression for tasks.ParseFilter.
+func (f *filterFlags) expr() string {
+	var parts []string
+	for _, tag := range f.tags {
+		parts = append(parts, "tag:"+tag)
+	}
+	if f.priority != "" {
+		parts = append(parts, "priority:"+f.priority)
+	}
+	if f.due != "" {
+		parts = append(parts, "due:"+f.due)
+	}
+	if f.status != "" {
+		parts = append(parts, "status:"+f.status)
+	}
+	return strings.Join(parts, " ")
+}
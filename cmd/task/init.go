@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var initForce bool
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Interactively create a config file",
+	Long:  "Walk through choosing a storage format, storage path, due-date format, and color theme, then write them to config.yaml.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return fmt.Errorf("finding home directory: %w", err)
+		}
+		configDir := filepath.Join(home, ".task")
+		configPath := filepath.Join(configDir, "config.yaml")
+
+		if _, err := os.Stat(configPath); err == nil && !initForce {
+			return fmt.Errorf("config already exists at %s (use --force to overwrite)", configPath)
+		}
+
+		var format string
+		if err := survey.AskOne(&survey.Select{
+			Message: "Which storage format should tasks be saved in?",
+			Options: []string{"json", "toml", "sqlite"},
+			Default: "json",
+		}, &format); err != nil {
+			return err
+		}
+
+		defaultStoragePath := filepath.Join(configDir, "tasks."+format)
+		var storagePath string
+		if err := survey.AskOne(&survey.Input{
+			Message: "Where should tasks be stored?",
+			Default: defaultStoragePath,
+		}, &storagePath); err != nil {
+			return err
+		}
+
+		var dueDateFormat string
+		if err := survey.AskOne(&survey.Input{
+			Message: "What format should due dates be shown in?",
+			Default: "2006-01-02",
+		}, &dueDateFormat); err != nil {
+			return err
+		}
+
+		var theme string
+		if err := survey.AskOne(&survey.Select{
+			Message: "Which color theme should task use?",
+			Options: []string{"default", "dark", "light", "none"},
+			Default: "default",
+		}, &theme); err != nil {
+			return err
+		}
+
+		var proceed bool
+		if err := survey.AskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Write config to %s?", configPath),
+			Default: true,
+		}, &proceed); err != nil {
+			return err
+		}
+		if !proceed {
+			fmt.Println("Aborted.")
+			return nil
+		}
+
+		if err := validateInit(configDir, storagePath, format); err != nil {
+			return err
+		}
+
+		config := map[string]any{
+			"storage": map[string]any{
+				"engine": format,
+				"path":   storagePath,
+			},
+			"dueDate": map[string]any{
+				"format": dueDateFormat,
+			},
+			"theme": map[string]any{
+				"name": theme,
+			},
+		}
+
+		data, err := yaml.Marshal(config)
+		if err != nil {
+			return fmt.Errorf("encoding config: %w", err)
+		}
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			return fmt.Errorf("creating config directory: %w", err)
+		}
+		if err := os.WriteFile(configPath, data, 0644); err != nil {
+			return fmt.Errorf("writing config: %w", err)
+		}
+
+		fmt.Printf("Config written to %s\n", configPath)
+		return nil
+	},
+}
+
+func init() {
+	initCmd.Flags().BoolVar(&initForce, "force", false, "overwrite an existing config file")
+}
+
+// validateInit checks the preconditions for the chosen configuration before
+// it is committed to disk.
+func validateInit(configDir, storagePath, format string) error {
+	if err := os.MkdirAll(filepath.Dir(storagePath), 0755); err != nil {
+		return fmt.Errorf("storage directory is not writable: %w", err)
+	}
+	probe := filepath.Join(filepath.Dir(storagePath), ".task-init-probe")
+	if err := os.WriteFile(probe, []byte("ok"), 0644); err != nil {
+		return fmt.Errorf("storage directory is not writable: %w", err)
+	}
+	os.Remove(probe)
+
+	if format == "sqlite" {
+		store, err := tasks.NewStore("sqlite", storagePath)
+		if err != nil {
+			return fmt.Errorf("opening sqlite database at %s: %w", storagePath, err)
+		}
+		if err := store.Close(); err != nil {
+			return fmt.Errorf("opening sqlite database at %s: %w", storagePath, err)
+		}
+	}
+
+	return nil
+}
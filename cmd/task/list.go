@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var listSort string
+
+var listFilter *filterFlags
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tasks (open ones by default)",
+	Long:  "List tasks, optionally narrowed with --tag/--priority/--due/--status and ordered with --sort. See 'go doc internal/tasks' for the filter and sort grammar.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := tasks.ParseFilter(listFilter.expr())
+		if err != nil {
+			return err
+		}
+		sortKeys, err := tasks.ParseSort(listSort)
+		if err != nil {
+			return err
+		}
+
+		store, err := openStore()
+		if err != nil {
+			return fmt.Errorf("opening task store: %w", err)
+		}
+		defer store.Close()
+
+		list, err := store.List(filter)
+		if err != nil {
+			return fmt.Errorf("loading tasks: %w", err)
+		}
+		tasks.Sort(list, sortKeys)
+
+		if len(list) == 0 {
+			fmt.Println("No tasks match.")
+			return nil
+		}
+
+		for _, t := range list {
+			status, mark := "open", " "
+			if t.Completed {
+				status, mark = "done", "x"
+			}
+			line := fmt.Sprintf("[%s] #%d (%s) %s",
+				renderer.Status(status, mark), t.ID,
+				renderer.Priority(t.Priority.String(), t.Priority.String()), t.Title)
+			if t.DueAt != nil {
+				line += fmt.Sprintf(" due:%s", t.DueAt.Format(dueDateFormat()))
+			}
+			for _, tag := range t.Tags {
+				line += " " + renderer.Tag("#"+tag)
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+func init() {
+	listFilter = addFilterFlags(listCmd)
+	listCmd.Flags().StringVar(&listSort, "sort", "", "sort order, e.g. due,-priority")
+}
@@ -0,0 +1,10 @@
+// Command task is a CLI for managing a personal to-do list, persisted on
+// disk as JSON, TOML, or SQLite (chosen by storage.engine).
+//
+// Build: go build -o task ./cmd/task
+// Run:   ./task add "Learn Go with Cobra"
+package main
+
+func main() {
+	Execute()
+}
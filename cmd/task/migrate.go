@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	migrateFrom string
+	migrateTo   string
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Move tasks between storage backends",
+	Long:  "Stream every task from one storage backend into another, e.g. 'task migrate --from json --to sqlite'.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if migrateFrom == "" || migrateTo == "" {
+			return fmt.Errorf("both --from and --to are required")
+		}
+
+		path := viper.GetString("storage.path")
+
+		from, err := tasks.NewStore(migrateFrom, path)
+		if err != nil {
+			return fmt.Errorf("opening source store: %w", err)
+		}
+		defer from.Close()
+
+		destPath := destinationPath(path, migrateTo)
+		to, err := tasks.NewStore(migrateTo, destPath)
+		if err != nil {
+			return fmt.Errorf("opening destination store: %w", err)
+		}
+		defer to.Close()
+
+		list, err := from.List(tasks.Filter{})
+		if err != nil {
+			return fmt.Errorf("reading source tasks: %w", err)
+		}
+
+		if err := copyTasks(list, to); err != nil {
+			return err
+		}
+
+		fmt.Printf("Migrated %d task(s) from %s to %s (%s)\n", len(list), migrateFrom, migrateTo, destPath)
+		return nil
+	},
+}
+
+func init() {
+	migrateCmd.Flags().StringVar(&migrateFrom, "from", "", "source storage engine (json, toml, sqlite)")
+	migrateCmd.Flags().StringVar(&migrateTo, "to", "", "destination storage engine (json, toml, sqlite)")
+}
+
+// copyTasks writes list into to, remapping ParentID so materialized
+// recurring instances still point at their template once both sides have
+// been assigned fresh IDs by the destination store. Templates (ParentID ==
+// nil) are copied first so every instance's new parent ID is already known
+// by the time it's copied.
+func copyTasks(list []tasks.Task, to tasks.Store) error {
+	idMap := make(map[int]int, len(list))
+
+	var templates, instances []tasks.Task
+	for _, t := range list {
+		if t.ParentID == nil {
+			templates = append(templates, t)
+		} else {
+			instances = append(instances, t)
+		}
+	}
+
+	for _, t := range templates {
+		oldID := t.ID
+		created, err := to.Add(t)
+		if err != nil {
+			return fmt.Errorf("writing task #%d: %w", oldID, err)
+		}
+		idMap[oldID] = created.ID
+	}
+
+	for _, t := range instances {
+		oldID := t.ID
+		newParentID, ok := idMap[*t.ParentID]
+		if !ok {
+			return fmt.Errorf("task #%d references parent #%d, which wasn't migrated", oldID, *t.ParentID)
+		}
+		t.ParentID = &newParentID
+		if _, err := to.Add(t); err != nil {
+			return fmt.Errorf("writing task #%d: %w", oldID, err)
+		}
+	}
+
+	return nil
+}
+
+// destinationPath swaps the extension of path for the one conventionally
+// used by the destination engine, so migrating doesn't clobber the source
+// file.
+func destinationPath(path, engine string) string {
+	ext := map[string]string{"json": ".json", "toml": ".toml", "sqlite": ".db"}[engine]
+	if ext == "" {
+		ext = "." + engine
+	}
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ext
+}
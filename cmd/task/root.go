@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/itzzjb/golang-cli-task-manager/internal/render"
+	"github.com/itzzjb/golang-cli-task-manager/internal/scheduler"
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var noColor bool
+
+// renderer formats task output according to the active theme. It is
+// rebuilt once config has been read, in initConfig.
+var renderer *render.Renderer
+
+var rootCmd = &cobra.Command{
+	Use:   "task",
+	Short: "Task manager cli",
+	Long:  "A simple command-line interface for managing tasks.",
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("Use 'task --help' for commands.")
+	},
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
+
+	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(addCmd)
+	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(completeCmd)
+	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(editCmd)
+	rootCmd.AddCommand(showCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(countCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(daemonCmd)
+}
+
+// Execute runs the root command, printing any error it returns before
+// exiting with a non-zero status.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		if renderer != nil {
+			fmt.Println(renderer.Warning(err.Error()))
+		} else {
+			fmt.Println(err)
+		}
+		os.Exit(1)
+	}
+}
+
+// initConfig loads config.yaml from ~/.task (falling back to the current
+// directory), sets the defaults used when no config file overrides them,
+// and builds the renderer for the active color theme.
+func initConfig() {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		fmt.Printf("Error finding home directory, %s\n", err)
+		home = "."
+	}
+
+	configDir := filepath.Join(home, ".task")
+	viper.SetDefault("storage.engine", "json")
+	viper.SetDefault("storage.path", filepath.Join(configDir, "tasks.json"))
+
+	viper.SetConfigName("config")
+	viper.SetConfigType("yaml")
+	viper.AddConfigPath(configDir)
+	viper.AddConfigPath(".")
+
+	if err := viper.ReadInConfig(); err != nil {
+		if _, notFound := err.(viper.ConfigFileNotFoundError); !notFound {
+			fmt.Printf("Error reading config file, %s\n", err)
+		}
+	}
+
+	// color.NoColor already accounts for NO_COLOR and a non-TTY stdout;
+	// --no-color only ever narrows it further, never re-enables color.
+	color.NoColor = color.NoColor || noColor
+
+	theme, err := render.LoadTheme(viper.GetViper())
+	if err != nil {
+		fmt.Printf("Error loading theme, %s\n", err)
+		theme = render.DefaultTheme()
+	}
+	renderer = render.New(theme)
+}
+
+// warnf prints a themed warning/error line to stdout.
+func warnf(format string, args ...any) {
+	fmt.Println(renderer.Warning(fmt.Sprintf(format, args...)))
+}
+
+// openStore opens the storage backend selected in the config.
+func openStore() (tasks.Store, error) {
+	return tasks.NewStore(viper.GetString("storage.engine"), viper.GetString("storage.path"))
+}
+
+// materializeDue creates pending instances for any recurring task that's
+// come due. It's called on every mutation (add/complete/delete/edit), in
+// addition to the task daemon's own loop, so recurring tasks show up
+// promptly without a daemon running. It goes through the same daemon lock as
+// 'task daemon' so a mutation racing the daemon (or another mutation)
+// can't double-materialize the same occurrence. Materialization failures
+// are reported as warnings rather than aborting the command that
+// triggered them.
+func materializeDue(store tasks.Store) {
+	created, err := scheduler.NewMaterializer(store, nil).MaterializeLocked(viper.GetString("storage.path"))
+	if err != nil {
+		warnf("Error materializing recurring tasks, %s", err)
+		return
+	}
+	for _, t := range created {
+		fmt.Printf("Materialized recurring task: #%d %s\n", t.ID, t.Title)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show the details of a task",
+	Long:  "Print every field of the task with the given ID.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			warnf("Please provide a task ID.")
+			return
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			warnf("Invalid task ID: %s", args[0])
+			return
+		}
+
+		store, err := openStore()
+		if err != nil {
+			warnf("Error opening task store, %s", err)
+			return
+		}
+		defer store.Close()
+
+		task, err := store.Get(id)
+		if err != nil {
+			warnf("No task found with ID %d", id)
+			return
+		}
+
+		status := "open"
+		if task.Completed {
+			status = "done"
+		}
+		tags := make([]string, len(task.Tags))
+		for i, tag := range task.Tags {
+			tags[i] = renderer.Tag("#" + tag)
+		}
+
+		fmt.Printf("%s %d\n", renderer.Header("ID:"), task.ID)
+		fmt.Printf("%s %s\n", renderer.Header("Title:"), task.Title)
+		fmt.Printf("%s %s\n", renderer.Header("Description:"), task.Description)
+		fmt.Printf("%s %s\n", renderer.Header("Priority:"), renderer.Priority(task.Priority.String(), task.Priority.String()))
+		fmt.Printf("%s %s\n", renderer.Header("Tags:"), strings.Join(tags, ", "))
+		fmt.Printf("%s %s\n", renderer.Header("Status:"), renderer.Status(status, status))
+		fmt.Printf("%s %s\n", renderer.Header("Created At:"), task.CreatedAt.Format("2006-01-02 15:04:05"))
+		if task.DueAt != nil {
+			fmt.Printf("%s %s\n", renderer.Header("Due At:"), task.DueAt.Format(dueDateFormat()))
+		}
+		if task.CompletedAt != nil {
+			fmt.Printf("%s %s\n", renderer.Header("Completed At:"), task.CompletedAt.Format("2006-01-02 15:04:05"))
+		}
+		if task.Recurrence != "" {
+			fmt.Printf("%s %s\n", renderer.Header("Recurrence:"), task.Recurrence)
+		}
+		if task.ParentID != nil {
+			fmt.Printf("%s #%d\n", renderer.Header("Recurring From:"), *task.ParentID)
+		}
+	},
+}
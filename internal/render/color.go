@@ -0,0 +1,75 @@
+// Package render turns task fields into themed, colorized text for the
+// terminal. It wraps fatih/color, so output automatically loses its
+// styling when NO_COLOR is set, stdout isn't a TTY, or the root command's
+// --no-color flag is passed (see cmd/task's use of color.NoColor).
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// Color is a terminal color that (un)marshals as its name ("red", "blue",
+// ...) rather than the underlying ANSI attribute, so a theme stays
+// human-editable in a config file.
+type Color color.Attribute
+
+var colorNames = map[string]Color{
+	"black":   Color(color.FgBlack),
+	"red":     Color(color.FgRed),
+	"green":   Color(color.FgGreen),
+	"yellow":  Color(color.FgYellow),
+	"blue":    Color(color.FgBlue),
+	"magenta": Color(color.FgMagenta),
+	"cyan":    Color(color.FgCyan),
+	"white":   Color(color.FgWhite),
+}
+
+func (c Color) name() string {
+	for name, v := range colorNames {
+		if v == c {
+			return name
+		}
+	}
+	return ""
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Color) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.name())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	resolved, ok := colorNames[name]
+	if !ok {
+		return fmt.Errorf("unknown color %q", name)
+	}
+	*c = resolved
+	return nil
+}
+
+// Sprint colors text with c, unless color.NoColor is set.
+func (c Color) Sprint(text string) string {
+	return color.New(color.Attribute(c)).Sprint(text)
+}
+
+// ColorMap maps a logical name, such as a priority level or a status, to
+// the Color it should render in.
+type ColorMap map[string]Color
+
+// Sprint colors text with the Color registered under key, or returns text
+// unchanged if key isn't in the map.
+func (m ColorMap) Sprint(key, text string) string {
+	c, ok := m[key]
+	if !ok {
+		return text
+	}
+	return c.Sprint(text)
+}
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// themeSource is the minimal part of viper.Viper that LoadTheme needs, so
+// it can be unit tested without a real viper instance.
+type themeSource interface {
+	Get(key string) any
+}
+
+// LoadTheme builds a Theme from the "theme" section of v, overlaid onto
+// DefaultTheme so an incomplete theme still renders every category.
+func LoadTheme(v themeSource) (Theme, error) {
+	theme := DefaultTheme()
+
+	raw := v.Get("theme")
+	if raw == nil {
+		return theme, nil
+	}
+
+	// viper decodes config files into generic maps; round-tripping through
+	// JSON lets us reuse Color's json.Unmarshaler instead of writing a
+	// second decoder for mapstructure.
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	// "task init" writes {"name": "<preset>"} rather than a full color
+	// map, so resolve that to one of the named presets before falling
+	// through to the general overlay below.
+	var named struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &named); err == nil && named.Name != "" {
+		if named.Name == "none" {
+			color.NoColor = true
+			return theme, nil
+		}
+		if preset, ok := presets[named.Name]; ok {
+			return preset, nil
+		}
+		return Theme{}, fmt.Errorf("unknown theme %q", named.Name)
+	}
+
+	var overrides Theme
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return Theme{}, err
+	}
+
+	theme.mergeFrom(overrides)
+	return theme, nil
+}
@@ -0,0 +1,40 @@
+package render
+
+// Renderer formats task fields using a Theme. All command output should
+// go through it rather than calling fatih/color directly, so a theme
+// change only has to happen in one place.
+type Renderer struct {
+	theme Theme
+}
+
+// New builds a Renderer for theme.
+func New(theme Theme) *Renderer {
+	return &Renderer{theme: theme}
+}
+
+// Priority colors text according to the theme's color for the given
+// priority level ("low", "med", "high", "critical").
+func (r *Renderer) Priority(level, text string) string {
+	return r.theme.Priority.Sprint(level, text)
+}
+
+// Status colors text according to the theme's color for the given status
+// ("open" or "done").
+func (r *Renderer) Status(status, text string) string {
+	return r.theme.Status.Sprint(status, text)
+}
+
+// Tag colors a tag name.
+func (r *Renderer) Tag(text string) string {
+	return r.theme.Tag.Sprint(text)
+}
+
+// Header colors a section header.
+func (r *Renderer) Header(text string) string {
+	return r.theme.Header.Sprint(text)
+}
+
+// Warning colors an error or warning line.
+func (r *Renderer) Warning(text string) string {
+	return r.theme.Warning.Sprint(text)
+}
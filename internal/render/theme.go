@@ -0,0 +1,91 @@
+package render
+
+// Theme names the colors used for each category of CLI output.
+type Theme struct {
+	Priority ColorMap `json:"priority"`
+	Status   ColorMap `json:"status"`
+	Tag      Color    `json:"tag"`
+	Header   Color    `json:"header"`
+	Warning  Color    `json:"warning"`
+}
+
+// DefaultTheme is used for any color a loaded theme doesn't set.
+func DefaultTheme() Theme {
+	return Theme{
+		Priority: ColorMap{
+			"low":      colorNames["white"],
+			"med":      colorNames["cyan"],
+			"high":     colorNames["yellow"],
+			"critical": colorNames["red"],
+		},
+		Status: ColorMap{
+			"open": colorNames["yellow"],
+			"done": colorNames["green"],
+		},
+		Tag:     colorNames["blue"],
+		Header:  colorNames["cyan"],
+		Warning: colorNames["red"],
+	}
+}
+
+// presets maps the named themes "task init" offers to a concrete Theme, so
+// a config file only has to record the chosen name.
+var presets = map[string]Theme{
+	"default": DefaultTheme(),
+	"dark": {
+		Priority: ColorMap{
+			"low":      colorNames["white"],
+			"med":      colorNames["blue"],
+			"high":     colorNames["magenta"],
+			"critical": colorNames["red"],
+		},
+		Status: ColorMap{
+			"open": colorNames["magenta"],
+			"done": colorNames["green"],
+		},
+		Tag:     colorNames["blue"],
+		Header:  colorNames["magenta"],
+		Warning: colorNames["red"],
+	},
+	"light": {
+		Priority: ColorMap{
+			"low":      colorNames["black"],
+			"med":      colorNames["blue"],
+			"high":     colorNames["yellow"],
+			"critical": colorNames["red"],
+		},
+		Status: ColorMap{
+			"open": colorNames["yellow"],
+			"done": colorNames["green"],
+		},
+		Tag:     colorNames["blue"],
+		Header:  colorNames["black"],
+		Warning: colorNames["red"],
+	},
+}
+
+// mergeFrom overlays the colors set in o onto t, leaving t's existing
+// colors in place for anything o doesn't set.
+func (t *Theme) mergeFrom(o Theme) {
+	for k, v := range o.Priority {
+		if t.Priority == nil {
+			t.Priority = ColorMap{}
+		}
+		t.Priority[k] = v
+	}
+	for k, v := range o.Status {
+		if t.Status == nil {
+			t.Status = ColorMap{}
+		}
+		t.Status[k] = v
+	}
+	if o.Tag != 0 {
+		t.Tag = o.Tag
+	}
+	if o.Header != 0 {
+		t.Header = o.Header
+	}
+	if o.Warning != 0 {
+		t.Warning = o.Warning
+	}
+}
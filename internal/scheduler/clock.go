@@ -0,0 +1,14 @@
+package scheduler
+
+import "time"
+
+// Clock abstracts time.Now so tests can fast-forward through schedules
+// without sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock is the Clock used outside of tests.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
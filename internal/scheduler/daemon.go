@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gen2brain/beeep"
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+)
+
+// Daemon periodically materializes due recurring tasks and notifies about
+// them.
+type Daemon struct {
+	Store       tasks.Store
+	StoragePath string
+	Clock       Clock
+}
+
+// RunOnce acquires the daemon lock, materializes any due tasks, and sends
+// a desktop notification for each one. If another daemon already holds
+// the lock it returns immediately with no error and no created tasks.
+func (d *Daemon) RunOnce() ([]tasks.Task, error) {
+	created, err := NewMaterializer(d.Store, d.Clock).MaterializeLocked(d.StoragePath)
+	if err != nil {
+		return created, err
+	}
+
+	for _, t := range created {
+		if err := beeep.Notify("Task due", t.Title, ""); err != nil {
+			return created, fmt.Errorf("notifying about task #%d: %w", t.ID, err)
+		}
+	}
+	return created, nil
+}
+
+// Status describes a recurring template's next scheduled fire time.
+type Status struct {
+	Task tasks.Task
+	Next time.Time
+}
+
+// Statuses returns the next fire time for every recurring template in the
+// store, without materializing anything.
+func Statuses(store tasks.Store, clock Clock) ([]Status, error) {
+	if clock == nil {
+		clock = realClock{}
+	}
+	all, err := store.List(tasks.Filter{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now()
+	var statuses []Status
+	for _, template := range all {
+		if template.Recurrence == "" || template.ParentID != nil {
+			continue
+		}
+		next, err := nextFireTime(all, template, now)
+		if err != nil {
+			return nil, fmt.Errorf("task #%d: %w", template.ID, err)
+		}
+		statuses = append(statuses, Status{Task: template, Next: next})
+	}
+	return statuses, nil
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gofrs/flock"
+)
+
+// Lock guards the materialize step with an exclusive file lock in the
+// storage directory, so two processes (the daemon or mutation commands)
+// never materialize the same occurrence twice. Notifications are sent
+// after the lock has been released.
+type Lock struct {
+	flock *flock.Flock
+}
+
+// NewLock creates a Lock backed by a "daemon.lock" file next to
+// storagePath.
+func NewLock(storagePath string) *Lock {
+	path := filepath.Join(filepath.Dir(storagePath), "daemon.lock")
+	return &Lock{flock: flock.New(path)}
+}
+
+// TryLock attempts to acquire the lock without blocking, returning false
+// if another process already holds it.
+func (l *Lock) TryLock() (bool, error) {
+	// The storage directory may not exist yet (e.g. the very first 'task
+	// add' on a fresh machine runs before the store has written anything),
+	// so make sure it's there before the lock file is opened in it.
+	if err := os.MkdirAll(filepath.Dir(l.flock.Path()), 0755); err != nil {
+		return false, fmt.Errorf("acquiring daemon lock: %w", err)
+	}
+	ok, err := l.flock.TryLock()
+	if err != nil {
+		return false, fmt.Errorf("acquiring daemon lock: %w", err)
+	}
+	return ok, nil
+}
+
+// Unlock releases the lock.
+func (l *Lock) Unlock() error {
+	return l.flock.Unlock()
+}
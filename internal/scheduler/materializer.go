@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+)
+
+// Materializer turns due recurring templates into pending task instances.
+type Materializer struct {
+	store tasks.Store
+	clock Clock
+}
+
+// NewMaterializer builds a Materializer. A nil clock uses the real wall
+// clock.
+func NewMaterializer(store tasks.Store, clock Clock) *Materializer {
+	if clock == nil {
+		clock = realClock{}
+	}
+	return &Materializer{store: store, clock: clock}
+}
+
+// Materialize checks every recurring template task and, if its next
+// occurrence is due, creates the pending instance. It returns every
+// instance created, so callers can notify about them.
+func (m *Materializer) Materialize() ([]tasks.Task, error) {
+	all, err := m.store.List(tasks.Filter{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.clock.Now()
+	var created []tasks.Task
+	for _, template := range all {
+		if template.Recurrence == "" || template.ParentID != nil {
+			continue
+		}
+
+		next, err := nextFireTime(all, template, now)
+		if err != nil {
+			return created, err
+		}
+		if next.After(now) {
+			continue
+		}
+
+		id := template.ID
+		instance, err := m.store.Add(tasks.Task{
+			Title:       template.Title,
+			Description: template.Description,
+			CreatedAt:   now,
+			Tags:        template.Tags,
+			Priority:    template.Priority,
+			DueAt:       &next,
+			ParentID:    &id,
+		})
+		if err != nil {
+			return created, fmt.Errorf("materializing task #%d: %w", template.ID, err)
+		}
+		created = append(created, instance)
+	}
+	return created, nil
+}
+
+// MaterializeLocked behaves like Materialize, but first acquires the same
+// file lock task daemon uses, so a mutation command racing the daemon (or
+// another mutation command) can't both observe the same due template and
+// create duplicate instances. If another process already holds the lock,
+// it returns immediately with no error and no created tasks.
+func (m *Materializer) MaterializeLocked(storagePath string) ([]tasks.Task, error) {
+	lock := NewLock(storagePath)
+	acquired, err := lock.TryLock()
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, nil
+	}
+	defer lock.Unlock()
+
+	return m.Materialize()
+}
+
+// nextFireTime computes the next time template should fire, based on the
+// most recent instance already materialized for it (or its creation time,
+// if none have been materialized yet).
+func nextFireTime(all []tasks.Task, template tasks.Task, now time.Time) (time.Time, error) {
+	base := template.CreatedAt
+	if last := latestInstance(all, template.ID); last != nil {
+		base = *last
+	}
+	return Next(template.Recurrence, base)
+}
+
+// latestInstance returns the due date of the most recently materialized
+// instance of templateID, or nil if none exists yet.
+func latestInstance(all []tasks.Task, templateID int) *time.Time {
+	var latest *time.Time
+	for _, t := range all {
+		if t.ParentID == nil || *t.ParentID != templateID || t.DueAt == nil {
+			continue
+		}
+		if latest == nil || t.DueAt.After(*latest) {
+			latest = t.DueAt
+		}
+	}
+	return latest
+}
@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/itzzjb/golang-cli-task-manager/internal/tasks"
+)
+
+// memStore is a minimal in-memory tasks.Store for tests.
+type memStore struct {
+	tasks []tasks.Task
+}
+
+func (s *memStore) Add(t tasks.Task) (tasks.Task, error) {
+	t.ID = tasks.NextID(s.tasks)
+	s.tasks = append(s.tasks, t)
+	return t, nil
+}
+
+func (s *memStore) Get(id int) (tasks.Task, error) {
+	t, i := tasks.Find(s.tasks, id)
+	if i == -1 {
+		return tasks.Task{}, tasks.ErrNotFound
+	}
+	return t, nil
+}
+
+func (s *memStore) List(filter tasks.Filter) ([]tasks.Task, error) {
+	return tasks.Apply(s.tasks, filter), nil
+}
+
+func (s *memStore) Update(t tasks.Task) error {
+	_, i := tasks.Find(s.tasks, t.ID)
+	if i == -1 {
+		return tasks.ErrNotFound
+	}
+	s.tasks[i] = t
+	return nil
+}
+
+func (s *memStore) Delete(id int) error {
+	_, i := tasks.Find(s.tasks, id)
+	if i == -1 {
+		return tasks.ErrNotFound
+	}
+	s.tasks = append(s.tasks[:i], s.tasks[i+1:]...)
+	return nil
+}
+
+func (s *memStore) Close() error { return nil }
+
+func TestMaterializeFastForwardsThroughLeapDay(t *testing.T) {
+	store := &memStore{}
+	created := time.Date(2024, time.February, 27, 0, 0, 0, 0, time.UTC)
+	template, err := store.Add(tasks.Task{Title: "standup", Recurrence: "daily", CreatedAt: created})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	clock := &fakeClock{now: created}
+	m := NewMaterializer(store, clock)
+
+	// Fast-forward one day at a time through the 2024 leap day, materializing
+	// once per day as a daemon tick would.
+	var dueDates []time.Time
+	for i := 0; i < 4; i++ {
+		clock.now = clock.now.AddDate(0, 0, 1)
+		createdInstances, err := m.Materialize()
+		if err != nil {
+			t.Fatalf("Materialize: %v", err)
+		}
+		for _, inst := range createdInstances {
+			dueDates = append(dueDates, *inst.DueAt)
+		}
+	}
+
+	want := []time.Time{
+		time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if len(dueDates) != len(want) {
+		t.Fatalf("got %d instances, want %d", len(dueDates), len(want))
+	}
+	for i, w := range want {
+		if !dueDates[i].Equal(w) {
+			t.Errorf("instance %d due at %s, want %s", i, dueDates[i], w)
+		}
+	}
+
+	all, err := store.List(tasks.Filter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 5 { // template + 4 instances
+		t.Errorf("got %d tasks in store, want 5", len(all))
+	}
+	_ = template
+}
+
+func TestMaterializeLockedSkipsWhenAnotherProcessHoldsTheLock(t *testing.T) {
+	store := &memStore{}
+	created := time.Date(2024, time.February, 27, 0, 0, 0, 0, time.UTC)
+	if _, err := store.Add(tasks.Task{Title: "standup", Recurrence: "daily", CreatedAt: created}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	storagePath := filepath.Join(t.TempDir(), "tasks.json")
+	holder := NewLock(storagePath)
+	acquired, err := holder.TryLock()
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	if !acquired {
+		t.Fatal("expected to acquire the lock")
+	}
+	defer holder.Unlock()
+
+	clock := &fakeClock{now: created.AddDate(0, 0, 1)}
+	m := NewMaterializer(store, clock)
+	instances, err := m.MaterializeLocked(storagePath)
+	if err != nil {
+		t.Fatalf("MaterializeLocked: %v", err)
+	}
+	if instances != nil {
+		t.Errorf("MaterializeLocked = %v while locked, want nil", instances)
+	}
+
+	all, err := store.List(tasks.Filter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 1 { // only the template; no instance was materialized
+		t.Errorf("got %d tasks in store, want 1", len(all))
+	}
+}
@@ -0,0 +1,125 @@
+// Package scheduler turns a recurring task template (tasks.Task.Recurrence)
+// into materialized task instances, and provides the single daemon pass
+// (Daemon.RunOnce) that the task daemon command repeats on a schedule.
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// Next returns the next time a task with the given recurrence should fire
+// after `after`. recurrence is one of "daily", "weekly:<days>",
+// "monthly:<days-of-month>", or "cron:<5-field expr>".
+func Next(recurrence string, after time.Time) (time.Time, error) {
+	kind, rest, _ := strings.Cut(recurrence, ":")
+
+	switch kind {
+	case "daily":
+		return startOfDay(after).AddDate(0, 0, 1), nil
+
+	case "weekly":
+		days, err := parseWeekdays(rest)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return nextWeekday(after, days), nil
+
+	case "monthly":
+		days, err := parseDaysOfMonth(rest)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return nextDayOfMonth(after, days), nil
+
+	case "cron":
+		schedule, err := cron.ParseStandard(rest)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid cron expression %q: %w", rest, err)
+		}
+		return schedule.Next(after), nil
+
+	default:
+		return time.Time{}, fmt.Errorf("unknown recurrence %q", recurrence)
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func parseWeekdays(expr string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, name := range strings.Split(expr, ",") {
+		day, ok := weekdays[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q", name)
+		}
+		days = append(days, day)
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("weekly recurrence needs at least one day")
+	}
+	return days, nil
+}
+
+// nextWeekday finds the start of the first day strictly after `after`
+// whose weekday is in days.
+func nextWeekday(after time.Time, days []time.Weekday) time.Time {
+	candidate := startOfDay(after).AddDate(0, 0, 1)
+	for i := 0; i < 7; i++ {
+		for _, d := range days {
+			if candidate.Weekday() == d {
+				return candidate
+			}
+		}
+		candidate = candidate.AddDate(0, 0, 1)
+	}
+	return candidate
+}
+
+func parseDaysOfMonth(expr string) ([]int, error) {
+	var days []int
+	for _, s := range strings.Split(expr, ",") {
+		day, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || day < 1 || day > 31 {
+			return nil, fmt.Errorf("invalid day of month %q", s)
+		}
+		days = append(days, day)
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("monthly recurrence needs at least one day")
+	}
+	return days, nil
+}
+
+// nextDayOfMonth finds the start of the first day strictly after `after`
+// whose day-of-month is in days, clamping days past the end of a short
+// month to that month's last day.
+func nextDayOfMonth(after time.Time, days []int) time.Time {
+	candidate := startOfDay(after).AddDate(0, 0, 1)
+	for i := 0; i < 62; i++ { // two months is enough to find a match
+		lastOfMonth := time.Date(candidate.Year(), candidate.Month()+1, 0, 0, 0, 0, 0, candidate.Location()).Day()
+		for _, d := range days {
+			target := d
+			if target > lastOfMonth {
+				target = lastOfMonth
+			}
+			if candidate.Day() == target {
+				return candidate
+			}
+		}
+		candidate = candidate.AddDate(0, 0, 1)
+	}
+	return candidate
+}
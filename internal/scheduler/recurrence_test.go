@@ -0,0 +1,133 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone database unavailable: %s", err)
+	}
+	return loc
+}
+
+func TestNextDailyAcrossSpringForwardDST(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+
+	// Clocks in America/New_York jump from 01:59 to 03:00 on 2024-03-10.
+	before := time.Date(2024, time.March, 9, 0, 0, 0, 0, ny)
+
+	got, err := Next("daily", before)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	want := time.Date(2024, time.March, 10, 0, 0, 0, 0, ny)
+	if !got.Equal(want) {
+		t.Errorf("Next(daily, %s) = %s, want %s", before, got, want)
+	}
+
+	got2, err := Next("daily", got)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	want2 := time.Date(2024, time.March, 11, 0, 0, 0, 0, ny)
+	if !got2.Equal(want2) {
+		t.Errorf("Next(daily, %s) = %s, want %s", got, got2, want2)
+	}
+}
+
+func TestNextDailyAcrossFallBackDST(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+
+	before := time.Date(2024, time.November, 2, 0, 0, 0, 0, ny)
+	got, err := Next("daily", before)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	want := time.Date(2024, time.November, 3, 0, 0, 0, 0, ny)
+	if !got.Equal(want) {
+		t.Errorf("Next(daily, %s) = %s, want %s", before, got, want)
+	}
+}
+
+func TestNextWeeklyAcrossLeapDay(t *testing.T) {
+	// 2024-02-26 is a Monday; the next Thursday crosses the 2024 leap day.
+	before := time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+
+	got, err := Next("weekly:thu", before)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	want := time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(weekly:thu, %s) = %s, want %s", before, got, want)
+	}
+}
+
+func TestNextMonthlyClampsShortFebruary(t *testing.T) {
+	before := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	got, err := Next("monthly:31", before)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	// 2023 is not a leap year, so day 31 clamps to February 28.
+	want := time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(monthly:31, %s) = %s, want %s", before, got, want)
+	}
+}
+
+func TestNextMonthlyOnLeapDay(t *testing.T) {
+	before := time.Date(2024, time.January, 29, 0, 0, 0, 0, time.UTC)
+
+	got, err := Next("monthly:29", before)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	want := time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next(monthly:29, %s) = %s, want %s", before, got, want)
+	}
+}
+
+func TestNextCronFastForwardThroughLeapDay(t *testing.T) {
+	before := time.Date(2024, time.February, 27, 9, 0, 0, 0, time.UTC)
+
+	clock := &fakeClock{now: before}
+	var fired []time.Time
+	for i := 0; i < 3; i++ {
+		next, err := Next("cron:0 9 * * *", clock.Now())
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		fired = append(fired, next)
+		clock.now = next
+	}
+
+	want := []time.Time{
+		time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+	}
+	for i, w := range want {
+		if !fired[i].Equal(w) {
+			t.Errorf("fire %d = %s, want %s", i, fired[i], w)
+		}
+	}
+}
+
+// fakeClock is a Clock whose Now() is fast-forwarded manually by tests.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
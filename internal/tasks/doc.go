@@ -0,0 +1,27 @@
+// Package tasks is the storage layer for the task manager. It owns the
+// Task model, the pluggable Store backends, and the filter/sort DSL used
+// to query tasks.
+//
+// # Filter grammar
+//
+// A filter expression is whitespace-separated tokens, each shaped
+// "key:value":
+//
+//	tag:<name>            task must have the given tag (repeatable)
+//	priority:<op><level>  op is one of =, >, >=, <, <= (default =);
+//	                       level is one of low, med, high, critical
+//	due:before:<when>      due date is before <when>
+//	due:after:<when>        due date is after <when>
+//	status:<open|done|all> task completion state; omitting it matches any
+//	                       status (the "list" command defaults to "open")
+//
+// <when> is either "today", "tomorrow", or a date in "2006-01-02" form.
+//
+// Example: "tag:work priority:>=high due:before:tomorrow status:open"
+//
+// # Sort grammar
+//
+// A sort expression is a comma-separated list of fields, each optionally
+// prefixed with "-" for descending order: "due,-priority". Supported
+// fields are id, title, priority, due, and created.
+package tasks
@@ -0,0 +1,141 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// fileStore is a Store backed by a single file holding the whole task list.
+// It re-reads the file on every call and rewrites it whole on every
+// mutation, which is simple but means large task lists get rewritten in
+// full for a single edit; see sqliteStore for a backend that avoids that.
+type fileStore struct {
+	path   string
+	decode func([]byte) ([]Task, error)
+	encode func([]Task) ([]byte, error)
+}
+
+func newJSONStore(path string) (Store, error) {
+	return &fileStore{
+		path: path,
+		decode: func(data []byte) ([]Task, error) {
+			var list []Task
+			err := json.Unmarshal(data, &list)
+			return list, err
+		},
+		encode: func(list []Task) ([]byte, error) {
+			return json.MarshalIndent(list, "", "  ")
+		},
+	}, nil
+}
+
+func newTOMLStore(path string) (Store, error) {
+	return &fileStore{
+		path: path,
+		decode: func(data []byte) ([]Task, error) {
+			var wrapper struct {
+				Tasks []Task `toml:"tasks"`
+			}
+			err := toml.Unmarshal(data, &wrapper)
+			return wrapper.Tasks, err
+		},
+		encode: func(list []Task) ([]byte, error) {
+			wrapper := struct {
+				Tasks []Task `toml:"tasks"`
+			}{Tasks: list}
+			var buf bytes.Buffer
+			if err := toml.NewEncoder(&buf).Encode(wrapper); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		},
+	}, nil
+}
+
+func (s *fileStore) load() ([]Task, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return []Task{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.decode(data)
+}
+
+func (s *fileStore) save(list []Task) error {
+	if dir := filepath.Dir(s.path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	data, err := s.encode(list)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0644)
+}
+
+func (s *fileStore) Add(t Task) (Task, error) {
+	list, err := s.load()
+	if err != nil {
+		return Task{}, err
+	}
+	t.ID = NextID(list)
+	list = append(list, t)
+	return t, s.save(list)
+}
+
+func (s *fileStore) Get(id int) (Task, error) {
+	list, err := s.load()
+	if err != nil {
+		return Task{}, err
+	}
+	t, index := Find(list, id)
+	if index == -1 {
+		return Task{}, ErrNotFound
+	}
+	return t, nil
+}
+
+func (s *fileStore) List(filter Filter) ([]Task, error) {
+	list, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return Apply(list, filter), nil
+}
+
+func (s *fileStore) Update(t Task) error {
+	list, err := s.load()
+	if err != nil {
+		return err
+	}
+	_, index := Find(list, t.ID)
+	if index == -1 {
+		return ErrNotFound
+	}
+	list[index] = t
+	return s.save(list)
+}
+
+func (s *fileStore) Delete(id int) error {
+	list, err := s.load()
+	if err != nil {
+		return err
+	}
+	_, index := Find(list, id)
+	if index == -1 {
+		return ErrNotFound
+	}
+	list = append(list[:index], list[index+1:]...)
+	return s.save(list)
+}
+
+func (s *fileStore) Close() error {
+	return nil
+}
@@ -0,0 +1,167 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PriorityPredicate compares a task's priority against Level using Op,
+// one of "=", ">", ">=", "<", "<=".
+type PriorityPredicate struct {
+	Op    string
+	Level Priority
+}
+
+func (p PriorityPredicate) match(level Priority) bool {
+	switch p.Op {
+	case ">":
+		return level > p.Level
+	case ">=":
+		return level >= p.Level
+	case "<":
+		return level < p.Level
+	case "<=":
+		return level <= p.Level
+	default:
+		return level == p.Level
+	}
+}
+
+// Filter narrows down a List call. The zero value matches every task.
+type Filter struct {
+	Tags      []string
+	Priority  *PriorityPredicate
+	DueBefore *time.Time
+	DueAfter  *time.Time
+	Status    string // "", "open", "done", or "all"
+}
+
+// Match reports whether t satisfies every condition in f.
+func (f Filter) Match(t Task) bool {
+	for _, tag := range f.Tags {
+		if !t.HasTag(tag) {
+			return false
+		}
+	}
+	if f.Priority != nil && !f.Priority.match(t.Priority) {
+		return false
+	}
+	if f.DueBefore != nil && (t.DueAt == nil || !t.DueAt.Before(*f.DueBefore)) {
+		return false
+	}
+	if f.DueAfter != nil && (t.DueAt == nil || !t.DueAt.After(*f.DueAfter)) {
+		return false
+	}
+	switch f.Status {
+	case "open":
+		return !t.Completed
+	case "done":
+		return t.Completed
+	default:
+		return true
+	}
+}
+
+// Apply returns the tasks in list that satisfy f.
+func Apply(list []Task, f Filter) []Task {
+	matched := make([]Task, 0, len(list))
+	for _, t := range list {
+		if f.Match(t) {
+			matched = append(matched, t)
+		}
+	}
+	return matched
+}
+
+// ParseFilter parses a filter expression (see the package doc for the
+// grammar) into a Filter.
+func ParseFilter(expr string) (Filter, error) {
+	var f Filter
+	for _, token := range strings.Fields(expr) {
+		key, value, ok := strings.Cut(token, ":")
+		if !ok {
+			return Filter{}, fmt.Errorf("invalid filter token %q, expected key:value", token)
+		}
+
+		switch key {
+		case "tag":
+			f.Tags = append(f.Tags, value)
+
+		case "priority":
+			pred, err := parsePriorityPredicate(value)
+			if err != nil {
+				return Filter{}, err
+			}
+			f.Priority = &pred
+
+		case "due":
+			bound, when, ok := strings.Cut(value, ":")
+			if !ok {
+				return Filter{}, fmt.Errorf("invalid due filter %q, expected before:<when> or after:<when>", value)
+			}
+			at, err := parseWhen(when)
+			if err != nil {
+				return Filter{}, err
+			}
+			switch bound {
+			case "before":
+				f.DueBefore = &at
+			case "after":
+				f.DueAfter = &at
+			default:
+				return Filter{}, fmt.Errorf("invalid due bound %q, expected before or after", bound)
+			}
+
+		case "status":
+			switch value {
+			case "open", "done", "all":
+				f.Status = value
+			default:
+				return Filter{}, fmt.Errorf("invalid status %q, expected open, done, or all", value)
+			}
+
+		default:
+			return Filter{}, fmt.Errorf("unknown filter key %q", key)
+		}
+	}
+	return f, nil
+}
+
+func parsePriorityPredicate(value string) (PriorityPredicate, error) {
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		if rest, ok := strings.CutPrefix(value, op); ok {
+			level, err := ParsePriority(rest)
+			if err != nil {
+				return PriorityPredicate{}, err
+			}
+			return PriorityPredicate{Op: op, Level: level}, nil
+		}
+	}
+	level, err := ParsePriority(value)
+	if err != nil {
+		return PriorityPredicate{}, err
+	}
+	return PriorityPredicate{Op: "=", Level: level}, nil
+}
+
+func parseWhen(when string) (time.Time, error) {
+	now := time.Now()
+	switch when {
+	case "today":
+		return startOfDay(now), nil
+	case "tomorrow":
+		return startOfDay(now.AddDate(0, 0, 1)), nil
+	default:
+		t, err := time.Parse("2006-01-02", when)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q, expected today, tomorrow, or YYYY-MM-DD", when)
+		}
+		return t, nil
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseFilterRejectsBadTokens(t *testing.T) {
+	cases := []string{
+		"tag",                // missing key:value
+		"due:before",         // missing :<when>
+		"due:sideways:today", // unknown bound
+		"due:before:never",   // unparseable when
+		"priority:medium+",   // unparseable priority
+		"status:maybe",       // unknown status
+		"color:blue",         // unknown key
+	}
+	for _, expr := range cases {
+		if _, err := ParseFilter(expr); err == nil {
+			t.Errorf("ParseFilter(%q) succeeded, want error", expr)
+		}
+	}
+}
+
+func TestParseFilterDueBeforeBoundary(t *testing.T) {
+	f, err := ParseFilter("due:before:2024-03-01")
+	if err != nil {
+		t.Fatalf("ParseFilter: %v", err)
+	}
+	if f.DueBefore == nil {
+		t.Fatal("DueBefore not set")
+	}
+
+	boundary := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	onBoundary := Task{DueAt: &boundary}
+	if f.Match(onBoundary) {
+		t.Error("task due exactly at the boundary should not match due:before (exclusive)")
+	}
+
+	before := boundary.AddDate(0, 0, -1)
+	beforeBoundary := Task{DueAt: &before}
+	if !f.Match(beforeBoundary) {
+		t.Error("task due before the boundary should match due:before")
+	}
+
+	noDue := Task{}
+	if f.Match(noDue) {
+		t.Error("task with no due date should not match due:before")
+	}
+}
+
+func TestParseFilterPriorityPredicate(t *testing.T) {
+	f, err := ParseFilter("priority:>=high")
+	if err != nil {
+		t.Fatalf("ParseFilter: %v", err)
+	}
+
+	if !f.Match(Task{Priority: PriorityHigh}) {
+		t.Error("expected priority:>=high to match PriorityHigh")
+	}
+	if !f.Match(Task{Priority: PriorityCritical}) {
+		t.Error("expected priority:>=high to match PriorityCritical")
+	}
+	if f.Match(Task{Priority: PriorityMedium}) {
+		t.Error("expected priority:>=high to reject PriorityMedium")
+	}
+}
+
+func TestApplyCombinesConditionsWithAnd(t *testing.T) {
+	list := []Task{
+		{ID: 1, Tags: []string{"work"}, Completed: false},
+		{ID: 2, Tags: []string{"work"}, Completed: true},
+		{ID: 3, Tags: []string{"home"}, Completed: false},
+	}
+
+	f, err := ParseFilter("tag:work status:open")
+	if err != nil {
+		t.Fatalf("ParseFilter: %v", err)
+	}
+
+	got := Apply(list, f)
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("Apply = %v, want only task #1", got)
+	}
+}
@@ -0,0 +1,63 @@
+package tasks
+
+import "fmt"
+
+// Priority ranks how urgent a task is. Higher values are more urgent, so
+// Priority values can be compared with the usual Go operators.
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityMedium
+	PriorityHigh
+	PriorityCritical
+)
+
+// ParsePriority converts a priority name (low, med/medium, high, critical)
+// into a Priority.
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "low":
+		return PriorityLow, nil
+	case "med", "medium":
+		return PriorityMedium, nil
+	case "high":
+		return PriorityHigh, nil
+	case "critical":
+		return PriorityCritical, nil
+	default:
+		return 0, fmt.Errorf("unknown priority %q", s)
+	}
+}
+
+// String returns the canonical name for p.
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityMedium:
+		return "med"
+	case PriorityHigh:
+		return "high"
+	case PriorityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler so Priority serializes as
+// its name in both JSON and TOML.
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Priority) UnmarshalText(text []byte) error {
+	parsed, err := ParsePriority(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortKey is one field of a sort expression: sort by Field, in descending
+// order if Desc is set.
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+var sortableFields = map[string]bool{
+	"id": true, "title": true, "priority": true, "due": true, "created": true,
+}
+
+// ParseSort parses a sort expression (see the package doc for the
+// grammar) into a list of SortKeys, applied in order as tie-breakers.
+func ParseSort(expr string) ([]SortKey, error) {
+	var keys []SortKey
+	for _, field := range strings.Split(expr, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		desc := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+		if !sortableFields[field] {
+			return nil, fmt.Errorf("unknown sort field %q", field)
+		}
+		keys = append(keys, SortKey{Field: field, Desc: desc})
+	}
+	return keys, nil
+}
+
+// Sort orders list in place according to keys, evaluated left to right.
+func Sort(list []Task, keys []SortKey) {
+	sort.SliceStable(list, func(i, j int) bool {
+		for _, k := range keys {
+			cmp := compare(list[i], list[j], k.Field)
+			if cmp == 0 {
+				continue
+			}
+			if k.Desc {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+}
+
+// compare returns a negative, zero, or positive number as a's field is
+// less than, equal to, or greater than b's.
+func compare(a, b Task, field string) int {
+	switch field {
+	case "id":
+		return a.ID - b.ID
+	case "title":
+		return strings.Compare(a.Title, b.Title)
+	case "priority":
+		return int(a.Priority) - int(b.Priority)
+	case "due":
+		return compareOptionalTime(a.DueAt, b.DueAt)
+	case "created":
+		return a.CreatedAt.Compare(b.CreatedAt)
+	default:
+		return 0
+	}
+}
+
+// compareOptionalTime orders tasks without a due date after every task
+// that has one.
+func compareOptionalTime(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return a.Compare(*b)
+	}
+}
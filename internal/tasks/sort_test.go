@@ -0,0 +1,70 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSortRejectsUnknownField(t *testing.T) {
+	if _, err := ParseSort("bogus"); err == nil {
+		t.Error("ParseSort(bogus) succeeded, want error")
+	}
+	if _, err := ParseSort("priority,-bogus"); err == nil {
+		t.Error("ParseSort(priority,-bogus) succeeded, want error")
+	}
+}
+
+func TestParseSortSkipsBlankFields(t *testing.T) {
+	keys, err := ParseSort(" due , ,-priority ")
+	if err != nil {
+		t.Fatalf("ParseSort: %v", err)
+	}
+	want := []SortKey{{Field: "due"}, {Field: "priority", Desc: true}}
+	if len(keys) != len(want) {
+		t.Fatalf("ParseSort = %v, want %v", keys, want)
+	}
+	for i, k := range want {
+		if keys[i] != k {
+			t.Errorf("key %d = %v, want %v", i, keys[i], k)
+		}
+	}
+}
+
+func TestSortUsesLaterKeysAsTiebreakers(t *testing.T) {
+	list := []Task{
+		{ID: 2, Priority: PriorityHigh, Title: "b"},
+		{ID: 1, Priority: PriorityHigh, Title: "a"},
+		{ID: 3, Priority: PriorityLow, Title: "c"},
+	}
+
+	keys, err := ParseSort("priority,title")
+	if err != nil {
+		t.Fatalf("ParseSort: %v", err)
+	}
+	Sort(list, keys)
+
+	want := []int{3, 1, 2} // low priority first (ascending), then title as tiebreaker within "high"
+	for i, id := range want {
+		if list[i].ID != id {
+			t.Errorf("position %d: got task #%d, want #%d", i, list[i].ID, id)
+		}
+	}
+}
+
+func TestSortOrdersMissingDueDatesLast(t *testing.T) {
+	due := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	list := []Task{
+		{ID: 1, DueAt: nil},
+		{ID: 2, DueAt: &due},
+	}
+
+	keys, err := ParseSort("due")
+	if err != nil {
+		t.Fatalf("ParseSort: %v", err)
+	}
+	Sort(list, keys)
+
+	if list[0].ID != 2 || list[1].ID != 1 {
+		t.Errorf("Sort(due) = %v, want task #2 before the due-less task #1", list)
+	}
+}
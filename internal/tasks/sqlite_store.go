@@ -0,0 +1,171 @@
+package tasks
+
+import (
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteStore stores tasks in a SQLite database, so mutating a single task
+// doesn't require rewriting every other task on disk the way fileStore does.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS tasks (
+	id           INTEGER PRIMARY KEY,
+	title        TEXT NOT NULL,
+	description  TEXT NOT NULL,
+	completed    INTEGER NOT NULL,
+	created_at   DATETIME NOT NULL,
+	tags         TEXT NOT NULL DEFAULT '[]',
+	priority     TEXT NOT NULL DEFAULT 'low',
+	due_at       DATETIME,
+	completed_at DATETIME,
+	recurrence   TEXT NOT NULL DEFAULT '',
+	parent_id    INTEGER
+);
+`
+
+func newSQLiteStore(path string) (Store, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) Add(t Task) (Task, error) {
+	tags, err := json.Marshal(t.Tags)
+	if err != nil {
+		return Task{}, err
+	}
+	res, err := s.db.Exec(
+		`INSERT INTO tasks (title, description, completed, created_at, tags, priority, due_at, completed_at, recurrence, parent_id)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		t.Title, t.Description, t.Completed, t.CreatedAt, string(tags), t.Priority.String(), t.DueAt, t.CompletedAt, t.Recurrence, t.ParentID,
+	)
+	if err != nil {
+		return Task{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Task{}, err
+	}
+	t.ID = int(id)
+	return t, nil
+}
+
+func (s *sqliteStore) Get(id int) (Task, error) {
+	row := s.db.QueryRow(
+		`SELECT id, title, description, completed, created_at, tags, priority, due_at, completed_at, recurrence, parent_id
+		 FROM tasks WHERE id = ?`, id,
+	)
+	t, err := scanTask(row)
+	if err == sql.ErrNoRows {
+		return Task{}, ErrNotFound
+	}
+	return t, err
+}
+
+func (s *sqliteStore) List(filter Filter) ([]Task, error) {
+	rows, err := s.db.Query(
+		`SELECT id, title, description, completed, created_at, tags, priority, due_at, completed_at, recurrence, parent_id
+		 FROM tasks ORDER BY id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []Task
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return Apply(list, filter), nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTask(row rowScanner) (Task, error) {
+	var (
+		t        Task
+		tags     string
+		priority string
+	)
+	if err := row.Scan(&t.ID, &t.Title, &t.Description, &t.Completed, &t.CreatedAt, &tags, &priority, &t.DueAt, &t.CompletedAt, &t.Recurrence, &t.ParentID); err != nil {
+		return Task{}, err
+	}
+	if err := json.Unmarshal([]byte(tags), &t.Tags); err != nil {
+		return Task{}, err
+	}
+	p, err := ParsePriority(priority)
+	if err != nil {
+		return Task{}, err
+	}
+	t.Priority = p
+	return t, nil
+}
+
+func (s *sqliteStore) Update(t Task) error {
+	tags, err := json.Marshal(t.Tags)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.Exec(
+		`UPDATE tasks SET title = ?, description = ?, completed = ?, created_at = ?, tags = ?, priority = ?, due_at = ?, completed_at = ?, recurrence = ?, parent_id = ?
+		 WHERE id = ?`,
+		t.Title, t.Description, t.Completed, t.CreatedAt, string(tags), t.Priority.String(), t.DueAt, t.CompletedAt, t.Recurrence, t.ParentID, t.ID,
+	)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res)
+}
+
+func (s *sqliteStore) Delete(id int) error {
+	res, err := s.db.Exec(`DELETE FROM tasks WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res)
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
+
+func checkRowsAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package tasks
+
+import "fmt"
+
+// Store is a storage backend for tasks. Every backend (json, toml, sqlite)
+// implements it so the cmd/task subcommands never depend on a concrete
+// storage format.
+type Store interface {
+	Add(t Task) (Task, error)
+	Get(id int) (Task, error)
+	List(filter Filter) ([]Task, error)
+	Update(t Task) error
+	Delete(id int) error
+	Close() error
+}
+
+// NewStore opens the storage backend named by engine (one of "json", "toml",
+// "sqlite") at path, creating it if it doesn't exist yet.
+func NewStore(engine, path string) (Store, error) {
+	switch engine {
+	case "", "json":
+		return newJSONStore(path)
+	case "toml":
+		return newTOMLStore(path)
+	case "sqlite":
+		return newSQLiteStore(path)
+	default:
+		return nil, fmt.Errorf("unknown storage engine %q", engine)
+	}
+}
+
+// ErrNotFound is returned by Get, Update, and Delete when no task matches
+// the given ID.
+var ErrNotFound = fmt.Errorf("task not found")
@@ -0,0 +1,60 @@
+package tasks
+
+import "time"
+
+// Task is a single to-do item managed by the CLI.
+type Task struct {
+	ID          int        `json:"id" toml:"id"`
+	Title       string     `json:"title" toml:"title"`
+	Description string     `json:"description" toml:"description"`
+	Completed   bool       `json:"completed" toml:"completed"`
+	CreatedAt   time.Time  `json:"created_at" toml:"created_at"`
+	Tags        []string   `json:"tags,omitempty" toml:"tags,omitempty"`
+	Priority    Priority   `json:"priority" toml:"priority"`
+	DueAt       *time.Time `json:"due_at,omitempty" toml:"due_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" toml:"completed_at,omitempty"`
+
+	// Recurrence marks a task as a recurring template, e.g. "daily",
+	// "weekly:mon,wed", "monthly:1,15", or "cron:<expr>". Empty for a
+	// normal, one-off task. See internal/scheduler for how templates are
+	// turned into pending instances.
+	Recurrence string `json:"recurrence,omitempty" toml:"recurrence,omitempty"`
+
+	// ParentID is set on a task materialized from a recurring template,
+	// pointing back at the template's ID. Nil for templates and for
+	// plain, non-recurring tasks.
+	ParentID *int `json:"parent_id,omitempty" toml:"parent_id,omitempty"`
+}
+
+// HasTag reports whether t is tagged with name.
+func (t Task) HasTag(name string) bool {
+	for _, tag := range t.Tags {
+		if tag == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NextID returns the next free ID for a new task, i.e. one greater than the
+// highest ID currently present in tasks.
+func NextID(tasks []Task) int {
+	max := 0
+	for _, t := range tasks {
+		if t.ID > max {
+			max = t.ID
+		}
+	}
+	return max + 1
+}
+
+// Find returns the task with the given ID and its index in tasks, or
+// index -1 if no such task exists.
+func Find(tasks []Task, id int) (Task, int) {
+	for i, t := range tasks {
+		if t.ID == id {
+			return t, i
+		}
+	}
+	return Task{}, -1
+}